@@ -20,12 +20,16 @@ package unstructured
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const errNoFieldOwner = "Apply requires a field owner, supply one with WithFieldOwner"
+
 // Wrapper returns the underlying *unstructured.Unstructured.
 type Wrapper interface {
 	GetUnstructured() *unstructured.Unstructured
@@ -36,6 +40,31 @@ type ListWrapper interface {
 	GetUnstructuredList() *unstructured.UnstructuredList
 }
 
+// ApplyOptions are used to configure a Server-Side Apply patch.
+type ApplyOptions struct {
+	FieldOwner     client.FieldOwner
+	ForceOwnership bool
+}
+
+// An ApplyOption configures Server-Side Apply options.
+type ApplyOption func(*ApplyOptions)
+
+// WithFieldOwner sets the field manager that owns the fields applied by an
+// Apply call.
+func WithFieldOwner(owner string) ApplyOption {
+	return func(o *ApplyOptions) {
+		o.FieldOwner = client.FieldOwner(owner)
+	}
+}
+
+// WithForce specifies whether an Apply call should take ownership of fields
+// already owned by another field manager.
+func WithForce(force bool) ApplyOption {
+	return func(o *ApplyOptions) {
+		o.ForceOwnership = force
+	}
+}
+
 // NewClient returns a client.Client that will operate on the underlying
 // *unstructured.Unstructured if the object satisfies the Wrapper or ListWrapper
 // interfaces. It relies on *unstructured.Unstructured instead of simpler
@@ -120,6 +149,36 @@ func (c *WrapperClient) Status() client.StatusWriter {
 	}
 }
 
+// SubResource returns a client for the supplied subresource.
+func (c *WrapperClient) SubResource(subResource string) client.SubResourceClient {
+	return &wrapperSubResourceClient{
+		kube: c.kube.SubResource(subResource),
+	}
+}
+
+// Apply uses Server-Side Apply to patch the given obj, taking ownership of
+// the fields it sets.
+func (c *WrapperClient) Apply(ctx context.Context, obj client.Object, opts ...ApplyOption) error {
+	o := &ApplyOptions{}
+	for _, fn := range opts {
+		fn(o)
+	}
+	if o.FieldOwner == "" {
+		return errors.New(errNoFieldOwner)
+	}
+
+	if u, ok := obj.(Wrapper); ok {
+		obj = u.GetUnstructured()
+	}
+
+	patchOpts := []client.PatchOption{o.FieldOwner}
+	if o.ForceOwnership {
+		patchOpts = append(patchOpts, client.ForceOwnership)
+	}
+
+	return c.kube.Patch(ctx, obj, client.Apply, patchOpts...)
+}
+
 // Scheme returns the scheme this client is using.
 func (c *WrapperClient) Scheme() *runtime.Scheme {
 	return c.kube.Scheme()
@@ -130,14 +189,48 @@ func (c *WrapperClient) RESTMapper() meta.RESTMapper {
 	return c.kube.RESTMapper()
 }
 
+// GroupVersionKindFor returns the GroupVersionKind for the given object.
+func (c *WrapperClient) GroupVersionKindFor(obj runtime.Object) (schema.GroupVersionKind, error) {
+	if u, ok := obj.(Wrapper); ok {
+		return c.kube.GroupVersionKindFor(u.GetUnstructured())
+	}
+	if u, ok := obj.(ListWrapper); ok {
+		return c.kube.GroupVersionKindFor(u.GetUnstructuredList())
+	}
+	return c.kube.GroupVersionKindFor(obj)
+}
+
+// IsObjectNamespaced returns true if the GroupVersionKind of the object is
+// namespaced.
+func (c *WrapperClient) IsObjectNamespaced(obj runtime.Object) (bool, error) {
+	if u, ok := obj.(Wrapper); ok {
+		return c.kube.IsObjectNamespaced(u.GetUnstructured())
+	}
+	if u, ok := obj.(ListWrapper); ok {
+		return c.kube.IsObjectNamespaced(u.GetUnstructuredList())
+	}
+	return c.kube.IsObjectNamespaced(obj)
+}
+
 type wrapperStatusClient struct {
 	kube client.StatusWriter
 }
 
+// Create creates the status subresource for the given obj.
+func (c *wrapperStatusClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	if u, ok := obj.(Wrapper); ok {
+		obj = u.GetUnstructured()
+	}
+	if u, ok := subResource.(Wrapper); ok {
+		subResource = u.GetUnstructured()
+	}
+	return c.kube.Create(ctx, obj, subResource, opts...)
+}
+
 // Update updates the fields corresponding to the status subresource for the
 // given obj. obj must be a struct pointer so that obj can be updated
 // with the content returned by the Server.
-func (c *wrapperStatusClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+func (c *wrapperStatusClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
 	if u, ok := obj.(Wrapper); ok {
 		return c.kube.Update(ctx, u.GetUnstructured(), opts...)
 	}
@@ -147,9 +240,104 @@ func (c *wrapperStatusClient) Update(ctx context.Context, obj client.Object, opt
 // Patch patches the given object's subresource. obj must be a struct
 // pointer so that obj can be updated with the content returned by the
 // Server.
-func (c *wrapperStatusClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+func (c *wrapperStatusClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if u, ok := obj.(Wrapper); ok {
+		return c.kube.Patch(ctx, u.GetUnstructured(), patch, opts...)
+	}
+	return c.kube.Patch(ctx, obj, patch, opts...)
+}
+
+// Apply uses Server-Side Apply to patch the status subresource of the given
+// obj, taking ownership of the fields it sets.
+func (c *wrapperStatusClient) Apply(ctx context.Context, obj client.Object, opts ...ApplyOption) error {
+	o := &ApplyOptions{}
+	for _, fn := range opts {
+		fn(o)
+	}
+	if o.FieldOwner == "" {
+		return errors.New(errNoFieldOwner)
+	}
+
+	if u, ok := obj.(Wrapper); ok {
+		obj = u.GetUnstructured()
+	}
+
+	patchOpts := []client.SubResourcePatchOption{o.FieldOwner}
+	if o.ForceOwnership {
+		patchOpts = append(patchOpts, client.ForceOwnership)
+	}
+
+	return c.kube.Patch(ctx, obj, client.Apply, patchOpts...)
+}
+
+// A wrapperSubResourceClient is a client.SubResourceClient that will operate
+// on the underlying *unstructured.Unstructured if the supplied object or
+// subresource body satisfies the Wrapper interface.
+type wrapperSubResourceClient struct {
+	kube client.SubResourceClient
+}
+
+// Get retrieves the subresource for the given obj.
+func (c *wrapperSubResourceClient) Get(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceGetOption) error {
+	if u, ok := obj.(Wrapper); ok {
+		obj = u.GetUnstructured()
+	}
+	if u, ok := subResource.(Wrapper); ok {
+		subResource = u.GetUnstructured()
+	}
+	return c.kube.Get(ctx, obj, subResource, opts...)
+}
+
+// Create creates the subresource for the given obj.
+func (c *wrapperSubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	if u, ok := obj.(Wrapper); ok {
+		obj = u.GetUnstructured()
+	}
+	if u, ok := subResource.(Wrapper); ok {
+		subResource = u.GetUnstructured()
+	}
+	return c.kube.Create(ctx, obj, subResource, opts...)
+}
+
+// Update updates the subresource for the given obj. obj must be a struct
+// pointer so that obj can be updated with the content returned by the
+// Server.
+func (c *wrapperSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if u, ok := obj.(Wrapper); ok {
+		return c.kube.Update(ctx, u.GetUnstructured(), opts...)
+	}
+	return c.kube.Update(ctx, obj, opts...)
+}
+
+// Patch patches the subresource for the given obj. obj must be a struct
+// pointer so that obj can be updated with the content returned by the
+// Server.
+func (c *wrapperSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 	if u, ok := obj.(Wrapper); ok {
 		return c.kube.Patch(ctx, u.GetUnstructured(), patch, opts...)
 	}
 	return c.kube.Patch(ctx, obj, patch, opts...)
 }
+
+// Apply uses Server-Side Apply to patch the subresource for the given obj,
+// taking ownership of the fields it sets.
+func (c *wrapperSubResourceClient) Apply(ctx context.Context, obj client.Object, opts ...ApplyOption) error {
+	o := &ApplyOptions{}
+	for _, fn := range opts {
+		fn(o)
+	}
+	if o.FieldOwner == "" {
+		return errors.New(errNoFieldOwner)
+	}
+
+	if u, ok := obj.(Wrapper); ok {
+		obj = u.GetUnstructured()
+	}
+
+	patchOpts := []client.SubResourcePatchOption{o.FieldOwner}
+	if o.ForceOwnership {
+		patchOpts = append(patchOpts, client.ForceOwnership)
+	}
+
+	return c.kube.Patch(ctx, obj, client.Apply, patchOpts...)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package unstructured
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// mockWrapper is a Wrapper that embeds an *unstructured.Unstructured so it
+// also satisfies client.Object.
+type mockWrapper struct {
+	*unstructured.Unstructured
+}
+
+func (m *mockWrapper) GetUnstructured() *unstructured.Unstructured {
+	return m.Unstructured
+}
+
+func newMockWrapper() *mockWrapper {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("example.org/v1")
+	u.SetKind("Example")
+	u.SetName("coolexample")
+	return &mockWrapper{Unstructured: u}
+}
+
+func TestWrapperClientApply(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		obj  client.Object
+		opts []ApplyOption
+	}
+	cases := map[string]struct {
+		reason  string
+		kube    client.Client
+		args    args
+		wantErr error
+	}{
+		"NoFieldOwner": {
+			reason: "Apply should reject calls that don't supply a field owner without touching the underlying client.",
+			args: args{
+				obj: newMockWrapper(),
+			},
+			wantErr: errors.New(errNoFieldOwner),
+		},
+		"UnwrapsAndPatchesWithSSA": {
+			reason: "Apply should unwrap the Wrapper and issue a Patch with client.Apply, the configured FieldOwner and ForceOwnership.",
+			kube: interceptor.NewClient(fake.NewClientBuilder().Build(), interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if _, ok := obj.(Wrapper); ok {
+						t.Errorf("Patch called with a Wrapper, want *unstructured.Unstructured")
+					}
+					if patch.Type() != types.ApplyPatchType {
+						t.Errorf("Patch called with type %q, want %q", patch.Type(), types.ApplyPatchType)
+					}
+					o := &client.PatchOptions{}
+					o.ApplyOptions(opts)
+					if o.FieldManager != "cool-controller" {
+						t.Errorf("FieldManager = %q, want %q", o.FieldManager, "cool-controller")
+					}
+					if o.Force == nil || !*o.Force {
+						t.Errorf("Force = %v, want true", o.Force)
+					}
+					return nil
+				},
+			}),
+			args: args{
+				obj:  newMockWrapper(),
+				opts: []ApplyOption{WithFieldOwner("cool-controller"), WithForce(true)},
+			},
+		},
+		"PropagatesPatchError": {
+			reason: "Apply should return any error returned by the underlying client's Patch call.",
+			kube: interceptor.NewClient(fake.NewClientBuilder().Build(), interceptor.Funcs{
+				Patch: func(_ context.Context, _ client.WithWatch, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					return errBoom
+				},
+			}),
+			args: args{
+				obj:  newMockWrapper(),
+				opts: []ApplyOption{WithFieldOwner("cool-controller")},
+			},
+			wantErr: errBoom,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(tc.kube)
+			err := c.Apply(context.Background(), tc.args.obj, tc.args.opts...)
+
+			if diff := cmp.Diff(tc.wantErr, err, cmp.Comparer(func(a, b error) bool {
+				if a == nil || b == nil {
+					return a == b
+				}
+				return a.Error() == b.Error()
+			})); diff != "" {
+				t.Errorf("\n%s\nApply(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWrapperStatusClientApply(t *testing.T) {
+	kube := interceptor.NewClient(fake.NewClientBuilder().Build(), interceptor.Funcs{
+		SubResourcePatch: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if subResourceName != "status" {
+				t.Errorf("subResourceName = %q, want %q", subResourceName, "status")
+			}
+			if _, ok := obj.(Wrapper); ok {
+				t.Errorf("Patch called with a Wrapper, want *unstructured.Unstructured")
+			}
+			if patch.Type() != types.ApplyPatchType {
+				t.Errorf("Patch called with type %q, want %q", patch.Type(), types.ApplyPatchType)
+			}
+			o := &client.SubResourcePatchOptions{}
+			o.ApplyOptions(opts)
+			if o.FieldManager != "cool-controller" {
+				t.Errorf("FieldManager = %q, want %q", o.FieldManager, "cool-controller")
+			}
+			return nil
+		},
+	})
+
+	c := NewClient(kube)
+	if err := c.Status().(interface {
+		Apply(ctx context.Context, obj client.Object, opts ...ApplyOption) error
+	}).Apply(context.Background(), newMockWrapper(), WithFieldOwner("cool-controller")); err != nil {
+		t.Errorf("Status().Apply(...): unexpected error: %v", err)
+	}
+}
+
+func TestWrapperSubResourceClientApply(t *testing.T) {
+	kube := interceptor.NewClient(fake.NewClientBuilder().Build(), interceptor.Funcs{
+		SubResourcePatch: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			if subResourceName != "scale" {
+				t.Errorf("subResourceName = %q, want %q", subResourceName, "scale")
+			}
+			if _, ok := obj.(Wrapper); ok {
+				t.Errorf("Patch called with a Wrapper, want *unstructured.Unstructured")
+			}
+			if patch.Type() != types.ApplyPatchType {
+				t.Errorf("Patch called with type %q, want %q", patch.Type(), types.ApplyPatchType)
+			}
+			return nil
+		},
+	})
+
+	c := NewClient(kube)
+	if err := c.SubResource("scale").(interface {
+		Apply(ctx context.Context, obj client.Object, opts ...ApplyOption) error
+	}).Apply(context.Background(), newMockWrapper(), WithFieldOwner("cool-controller")); err != nil {
+		t.Errorf("SubResource(\"scale\").Apply(...): unexpected error: %v", err)
+	}
+}